@@ -0,0 +1,50 @@
+package nats
+
+import "github.com/nats-io/nats.go"
+
+// Connection abstracts the subset of *nats.Conn behavior the Subscriber needs, so that
+// plain NATS and JetStream-backed connections can be used interchangeably.
+type Connection interface {
+	QueueSubscribe(subj, queue string, cb nats.MsgHandler) (*nats.Subscription, error)
+	PublishMsg(msg *nats.Msg) error
+	Drain() error
+}
+
+// jsSubscriber is implemented by connections that can create JetStream pull and ordered
+// consumers, in addition to the regular push-based Connection. Subscriber type-asserts to
+// this interface for every subscription when JetStream is enabled (push, pull and ordered).
+type jsSubscriber interface {
+	Subscribe(subj string, cb nats.MsgHandler, opts ...nats.SubOpt) (*nats.Subscription, error)
+	PullSubscribe(subj, durable string, opts ...nats.SubOpt) (*nats.Subscription, error)
+	QueueSubscribeDurable(subj, queue, durable string, cb nats.MsgHandler, opts ...nats.SubOpt) (*nats.Subscription, error)
+}
+
+// jsConnection wraps a *nats.Conn and its JetStream context so JetStream-enabled
+// subscribers can subscribe via the JetStream APIs while still draining the
+// underlying connection on Close.
+type jsConnection struct {
+	*nats.Conn
+	js     nats.JetStreamContext
+	config JetStreamConfig
+}
+
+func (c *jsConnection) QueueSubscribe(subj, queue string, cb nats.MsgHandler) (*nats.Subscription, error) {
+	return c.js.QueueSubscribe(subj, queue, cb)
+}
+
+func (c *jsConnection) Subscribe(subj string, cb nats.MsgHandler, opts ...nats.SubOpt) (*nats.Subscription, error) {
+	return c.js.Subscribe(subj, cb, opts...)
+}
+
+func (c *jsConnection) PullSubscribe(subj, durable string, opts ...nats.SubOpt) (*nats.Subscription, error) {
+	return c.js.PullSubscribe(subj, durable, opts...)
+}
+
+// QueueSubscribeDurable creates a JetStream queue subscription bound to an explicit durable
+// consumer name, so topics sharing a queue group prefix don't collide on one shared consumer.
+func (c *jsConnection) QueueSubscribeDurable(subj, queue, durable string, cb nats.MsgHandler, opts ...nats.SubOpt) (*nats.Subscription, error) {
+	if durable == "" {
+		return c.js.QueueSubscribe(subj, queue, cb, opts...)
+	}
+	return c.js.QueueSubscribe(subj, queue, cb, append(opts, nats.Durable(durable))...)
+}
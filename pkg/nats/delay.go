@@ -0,0 +1,75 @@
+package nats
+
+import (
+	"math/rand"
+	"time"
+)
+
+// ExponentialBackoffDelay is a Delay implementation computing
+// min(Base * 2^(numDelivered-1), Max) + jitter, so redeliveries back off exponentially instead
+// of hammering a failing consumer at a fixed NakDelay. Once numDelivered exceeds
+// TerminalDeliveryCount, WaitTime returns StopTime so the caller terms the message instead of
+// nacking it again.
+type ExponentialBackoffDelay struct {
+	// Base is the delay used for the first redelivery.
+	Base time.Duration
+
+	// Max caps the computed backoff, before jitter is added. Zero means uncapped.
+	Max time.Duration
+
+	// JitterFraction adds up to JitterFraction*backoff of random jitter on top of the
+	// computed backoff, to avoid redelivery stampedes. Zero disables jitter.
+	JitterFraction float64
+
+	// TerminalDeliveryCount is the delivery count after which WaitTime returns StopTime
+	// instead of a backoff, so the caller terms the message. Zero disables termination.
+	TerminalDeliveryCount uint64
+}
+
+func (d *ExponentialBackoffDelay) setDefaults() {
+	if d.Base <= 0 {
+		d.Base = time.Second
+	}
+}
+
+// WaitTime implements Delay.
+func (d *ExponentialBackoffDelay) WaitTime(numDelivered uint64) time.Duration {
+	d.setDefaults()
+
+	if d.TerminalDeliveryCount > 0 && numDelivered > d.TerminalDeliveryCount {
+		return StopTime
+	}
+
+	backoff := d.Base * time.Duration(uint64(1)<<(numDelivered-1))
+	if d.Max > 0 && backoff > d.Max {
+		backoff = d.Max
+	}
+
+	if d.JitterFraction > 0 {
+		backoff += time.Duration(float64(backoff) * d.JitterFraction * rand.Float64())
+	}
+
+	return backoff
+}
+
+// Schedule returns the deterministic (jitter-free) backoff for each delivery attempt up to
+// TerminalDeliveryCount, suitable for propagating into nats.ConsumerConfig.BackOff so the
+// server enforces the same schedule for messages the client never sees due to a crash.
+func (d *ExponentialBackoffDelay) Schedule() []time.Duration {
+	d.setDefaults()
+
+	if d.TerminalDeliveryCount == 0 {
+		return nil
+	}
+
+	schedule := make([]time.Duration, d.TerminalDeliveryCount)
+	for i := range schedule {
+		backoff := d.Base * time.Duration(uint64(1)<<i)
+		if d.Max > 0 && backoff > d.Max {
+			backoff = d.Max
+		}
+		schedule[i] = backoff
+	}
+
+	return schedule
+}
@@ -0,0 +1,64 @@
+package nats
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+// idempotencyStore deduplicates processed message UUIDs using a JetStream KV bucket, so that
+// a redelivered message (e.g. after a crash between Ack and downstream processing) is dropped
+// instead of being forwarded to the output channel again.
+type idempotencyStore struct {
+	kv nats.KeyValue
+}
+
+func newIdempotencyStore(js nats.JetStreamContext, config IdempotencyStoreConfig) (*idempotencyStore, error) {
+	config.setDefaults()
+
+	kv, err := js.KeyValue(config.Bucket)
+	if errors.Is(err, nats.ErrBucketNotFound) {
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket: config.Bucket,
+			TTL:    config.TTL,
+		})
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open idempotency store bucket")
+	}
+
+	return &idempotencyStore{kv: kv}, nil
+}
+
+// Seen reports whether uuid has already been recorded as processed. It does not itself record
+// anything, so a message must only be dropped as a duplicate once Seen returns true - acting on
+// it requires a corresponding successful MarkSeen from a previous delivery.
+func (s *idempotencyStore) Seen(uuid string) (bool, error) {
+	_, err := s.kv.Get(uuid)
+	if errors.Is(err, nats.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "cannot read idempotency store")
+	}
+
+	return true, nil
+}
+
+// MarkSeen records uuid as processed, and must only be called after the message has been
+// successfully Acked - marking it any earlier (e.g. before forwarding to the output channel)
+// would permanently drop the uuid on any outcome other than an immediate Ack (a Nack, an
+// Ack-timeout, or a crash before Ack), since JetStream's redelivery of that exact message would
+// then find it pre-claimed and never actually process it. It uses kv.Create rather than kv.Put
+// so two deliveries racing to mark the same uuid after both Acked don't silently overwrite one
+// another; the loser's error is not a failure, the uuid is recorded either way.
+func (s *idempotencyStore) MarkSeen(uuid string) error {
+	_, err := s.kv.Create(uuid, []byte{1})
+	if errors.Is(err, nats.ErrKeyExists) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot write idempotency store")
+	}
+
+	return nil
+}
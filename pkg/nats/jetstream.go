@@ -0,0 +1,95 @@
+package nats
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// JetStreamConfig holds JetStream specific settings
+type JetStreamConfig struct {
+	// Enabled indicates if JetStream is used for this connection
+	Enabled bool
+
+	// AutoProvision indicates if streams should be automatically provisioned to match topics.
+	AutoProvision bool
+
+	// ConnectOptions are custom []nats.JSOpt passed to the JetStream context on creation.
+	ConnectOptions []nats.JSOpt
+
+	// PullConsumer, when set, switches the subscriber from push-based QueueSubscribe to a
+	// JetStream pull consumer driven by repeated Fetch calls. Mutually exclusive with QueueGroup.
+	PullConsumer *PullConsumerConfig
+
+	// Ordered creates an ephemeral ordered consumer (nats.OrderedConsumer()) instead of a
+	// regular push subscription. Ordered consumers guarantee in-order, gap-free delivery to
+	// a single subscriber by transparently recreating the consumer on any detected gap.
+	// Mutually exclusive with QueueGroup and PullConsumer.
+	Ordered bool
+
+	// IdleHeartbeat sets how often the server sends a heartbeat while the consumer is idle,
+	// so a dead or stalled subscription can be detected quickly. Only used when Ordered is set.
+	IdleHeartbeat time.Duration
+
+	// FlowControl enables server-side flow control messages for the consumer, which the nats.go
+	// client handles transparently. Only used when Ordered is set.
+	FlowControl bool
+
+	// IdempotencyStore, when set, deduplicates delivered messages by UUID using a JetStream
+	// KV bucket, so a redelivered message is Acked and dropped instead of being forwarded
+	// to the output channel a second time.
+	IdempotencyStore *IdempotencyStoreConfig
+
+	// MaxDeliver caps how many times JetStream will redeliver a message before giving up. It is
+	// propagated to nats.ConsumerConfig.MaxDeliver via nats.MaxDeliver() on every consumer this
+	// Subscriber creates, so it's enforced server-side even for messages the client never sees
+	// because it crashed before the redelivery arrived.
+	MaxDeliver int
+
+	// ConsumerBackOff is propagated to nats.ConsumerConfig.BackOff via nats.BackOff() on every
+	// consumer this Subscriber creates. Set it to
+	// SubscriberConfig.NakDelay.(*ExponentialBackoffDelay).Schedule() to keep the client-side
+	// NakDelay and the server-side redelivery schedule in sync.
+	ConsumerBackOff []time.Duration
+}
+
+// IdempotencyStoreConfig configures the KV bucket backing Subscriber-side deduplication.
+type IdempotencyStoreConfig struct {
+	// Bucket is the name of the JetStream KV bucket used to record processed message UUIDs.
+	Bucket string
+
+	// TTL is how long a UUID is remembered before it can be redelivered and reprocessed again.
+	TTL time.Duration
+}
+
+func (c *IdempotencyStoreConfig) setDefaults() {
+	if c.Bucket == "" {
+		c.Bucket = "watermill-idempotency"
+	}
+}
+
+// PullConsumerConfig configures a JetStream pull consumer.
+type PullConsumerConfig struct {
+	// Durable is the durable consumer name passed to SubscriberConfig.DurableCalculator(topic, Durable)
+	// before being used for js.PullSubscribe, so two topics sharing a Durable can still be told
+	// apart the same way QueueGroup-based push subscriptions already are.
+	Durable string
+
+	// BatchSize is the number of messages requested per Fetch call.
+	BatchSize int
+
+	// MaxWait is how long Fetch will block waiting for at least one message.
+	MaxWait time.Duration
+
+	// MaxAckPending limits the number of outstanding unacknowledged messages for the consumer.
+	MaxAckPending int
+}
+
+func (c *PullConsumerConfig) setDefaults() {
+	if c.BatchSize <= 0 {
+		c.BatchSize = 10
+	}
+	if c.MaxWait <= 0 {
+		c.MaxWait = time.Second * 5
+	}
+}
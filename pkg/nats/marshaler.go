@@ -0,0 +1,16 @@
+package nats
+
+import (
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/nats-io/nats.go"
+)
+
+// Marshaler marshals a watermill Message into a nats.Msg ready to be published to topic.
+type Marshaler interface {
+	Marshal(topic string, msg *message.Message) (*nats.Msg, error)
+}
+
+// Unmarshaler unmarshals a nats.Msg received from NATS back into a watermill Message.
+type Unmarshaler interface {
+	Unmarshal(msg *nats.Msg) (*message.Message, error)
+}
@@ -0,0 +1,168 @@
+package nats
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+// natsMsgIDHeader is the JetStream header consulted by the stream's duplicate window to
+// deduplicate republished messages.
+const natsMsgIDHeader = "Nats-Msg-Id"
+
+// PublisherConfig is the configuration to create a publisher
+type PublisherConfig struct {
+	// URL is the URL to the broker
+	URL string
+
+	// NatsOptions are custom []nats.Option passed to the connection.
+	NatsOptions []nats.Option
+
+	// Marshaler is used to marshal messages from Watermill format to NATS format.
+	Marshaler Marshaler
+
+	// JetStream holds JetStream specific settings
+	JetStream JetStreamConfig
+
+	// DeduplicationWindow, when set, stamps every outgoing message with a Nats-Msg-Id header
+	// derived from its UUID, so republishing the same message within the stream's own
+	// duplicate window (which must be configured to at least this value) is a no-op on the
+	// server. It has no effect unless JetStream is enabled.
+	DeduplicationWindow time.Duration
+}
+
+func (c *PublisherConfig) setDefaults() {
+	if c.Marshaler == nil {
+		c.Marshaler = &NATSMarshaler{}
+	}
+}
+
+func (c *PublisherConfig) Validate() error {
+	if c.Marshaler == nil {
+		return errors.New("PublisherConfig.Marshaler is missing")
+	}
+
+	return nil
+}
+
+// Publisher provides the nats implementation for watermill publish operations
+type Publisher struct {
+	conn   *nats.Conn
+	js     nats.JetStreamContext
+	config PublisherConfig
+}
+
+// NewPublisher creates a new Publisher.
+func NewPublisher(config PublisherConfig, logger watermill.LoggerAdapter) (*Publisher, error) {
+	config.setDefaults()
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	conn, err := nats.Connect(config.URL, config.NatsOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot connect to NATS")
+	}
+
+	var js nats.JetStreamContext
+	if config.JetStream.Enabled {
+		js, err = conn.JetStream(config.JetStream.ConnectOptions...)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create JetStream context")
+		}
+	}
+
+	return &Publisher{
+		conn:   conn,
+		js:     js,
+		config: config,
+	}, nil
+}
+
+// Publish publishes message to NATS.
+//
+// When JetStream is enabled, Publish will not return until an ack has been received from the
+// broker for each message.
+func (p *Publisher) Publish(topic string, messages ...*message.Message) error {
+	for _, msg := range messages {
+		natsMsg, err := p.config.Marshaler.Marshal(topic, msg)
+		if err != nil {
+			return errors.Wrap(err, "cannot marshal message")
+		}
+
+		if p.config.DeduplicationWindow > 0 {
+			if natsMsg.Header == nil {
+				natsMsg.Header = nats.Header{}
+			}
+			natsMsg.Header.Set(natsMsgIDHeader, msg.UUID)
+		}
+
+		if p.js != nil {
+			if _, err := p.js.PublishMsg(natsMsg); err != nil {
+				return errors.Wrap(err, "cannot publish msg")
+			}
+			continue
+		}
+
+		if err := p.conn.PublishMsg(natsMsg); err != nil {
+			return errors.Wrap(err, "cannot publish msg")
+		}
+	}
+
+	return nil
+}
+
+// Request publishes msg to topic on a reply inbox allocated via nats.NewInbox(), waits for a
+// single reply until ctx is done, and returns it unmarshaled. See replyToHeader for why the
+// inbox is carried in a header rather than nats.Msg.Reply.
+func (p *Publisher) Request(ctx context.Context, topic string, msg *message.Message) (*message.Message, error) {
+	natsMsg, err := p.config.Marshaler.Marshal(topic, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal message")
+	}
+
+	inbox := nats.NewInbox()
+
+	if natsMsg.Header == nil {
+		natsMsg.Header = nats.Header{}
+	}
+	natsMsg.Header.Set(replyToHeader, inbox)
+
+	sub, err := p.conn.SubscribeSync(inbox)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot subscribe to reply inbox")
+	}
+	defer sub.Unsubscribe()
+
+	if err := p.conn.PublishMsg(natsMsg); err != nil {
+		return nil, errors.Wrap(err, "cannot publish request")
+	}
+
+	reply, err := sub.NextMsgWithContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot receive reply")
+	}
+
+	unmarshaler, ok := p.config.Marshaler.(Unmarshaler)
+	if !ok {
+		return nil, errors.New("PublisherConfig.Marshaler must also implement Unmarshaler to use Request")
+	}
+
+	replyMsg, err := unmarshaler.Unmarshal(reply)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal reply")
+	}
+
+	return replyMsg, nil
+}
+
+// Close closes the publisher and the underlying connection.
+func (p *Publisher) Close() error {
+	p.conn.Close()
+	return nil
+}
@@ -1,7 +1,9 @@
 package nats_test
 
 import (
+	"context"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -18,7 +20,7 @@ import (
 func getTestFeatures() tests.Features {
 	return tests.Features{
 		ConsumerGroups:                      false,
-		ExactlyOnceDelivery:                 false,
+		ExactlyOnceDelivery:                 true,
 		GuaranteedOrder:                     false,
 		GuaranteedOrderWithSingleSubscriber: true,
 		Persistent:                          false,
@@ -63,9 +65,22 @@ func newPubSub(t *testing.T, clientID string, queueName string, exactlyOnce bool
 		URL:         natsURL,
 		Marshaler:   marshaler,
 		NatsOptions: options,
+		JetStream:   wmnats.JetStreamConfig{Enabled: exactlyOnce},
 	}, logger)
 	require.NoError(t, err)
 
+	subscriberJetStream := wmnats.JetStreamConfig{}
+	if exactlyOnce {
+		// AckSync alone only guarantees at-least-once delivery; the idempotency store is what
+		// turns a redelivery after a crash between Ack and downstream processing into a no-op,
+		// which is what makes this factory satisfy tests.Features.ExactlyOnceDelivery.
+		subscriberJetStream = wmnats.JetStreamConfig{
+			Enabled:          true,
+			AutoProvision:    true,
+			IdempotencyStore: &wmnats.IdempotencyStoreConfig{},
+		}
+	}
+
 	sub, err := wmnats.NewSubscriber(wmnats.SubscriberConfig{
 		URL:              natsURL,
 		QueueGroup:       queueName,
@@ -75,6 +90,7 @@ func newPubSub(t *testing.T, clientID string, queueName string, exactlyOnce bool
 		NatsOptions:      options,
 		CloseTimeout:     30 * time.Second,
 		AckSync:          exactlyOnce,
+		JetStream:        subscriberJetStream,
 	}, logger)
 	require.NoError(t, err)
 
@@ -89,7 +105,6 @@ func createPubSubWithConsumerGroup(t *testing.T, consumerGroup string) (message.
 	return newPubSub(t, watermill.NewUUID(), consumerGroup, false)
 }
 
-//nolint:deadcode,unused
 func createPubSubWithExactlyOnce(t *testing.T) (message.Publisher, message.Subscriber) {
 	return newPubSub(t, watermill.NewUUID(), "", true)
 }
@@ -98,3 +113,215 @@ func createPubSubWithExactlyOnce(t *testing.T) (message.Publisher, message.Subsc
 func createPubSubWithConsumerGroupWithExactlyOnce(t *testing.T, consumerGroup string) (message.Publisher, message.Subscriber) {
 	return newPubSub(t, watermill.NewUUID(), consumerGroup, true)
 }
+
+// newOrderedPubSub builds a publisher and a JetStream.Ordered subscriber pair for exercising
+// gap-free, in-order delivery to a single subscriber.
+func newOrderedPubSub(t *testing.T, clientID string) (message.Publisher, message.Subscriber) {
+	natsURL := os.Getenv("WATERMILL_TEST_NATS_URL")
+	if natsURL == "" {
+		natsURL = nats.DefaultURL
+	}
+
+	format := os.Getenv("WATERMILL_TEST_NATS_FORMAT")
+	marshaler := msg.GetMarshaler(format)
+	logger := watermill.NopLogger{}
+
+	options := []nats.Option{
+		nats.RetryOnFailedConnect(true),
+		nats.Timeout(30 * time.Second),
+		nats.ReconnectWait(1 * time.Second),
+		nats.Name(clientID),
+	}
+
+	pub, err := wmnats.NewPublisher(wmnats.PublisherConfig{
+		URL:         natsURL,
+		Marshaler:   marshaler,
+		NatsOptions: options,
+		JetStream:   wmnats.JetStreamConfig{Enabled: true},
+	}, logger)
+	require.NoError(t, err)
+
+	sub, err := wmnats.NewSubscriber(wmnats.SubscriberConfig{
+		URL:            natsURL,
+		Unmarshaler:    marshaler,
+		NatsOptions:    options,
+		AckWaitTimeout: 30 * time.Second,
+		CloseTimeout:   30 * time.Second,
+		JetStream: wmnats.JetStreamConfig{
+			Enabled:       true,
+			AutoProvision: true,
+			Ordered:       true,
+		},
+	}, logger)
+	require.NoError(t, err)
+
+	return pub, sub
+}
+
+// TestOrderedConsumerDeliversInOrder exercises SubscriberConfig.JetStream.Ordered end to end:
+// messages published in sequence must be delivered to the single subscriber in that same
+// sequence.
+func TestOrderedConsumerDeliversInOrder(t *testing.T) {
+	topic := "ordered_" + watermill.NewUUID()
+	pub, sub := newOrderedPubSub(t, watermill.NewUUID())
+	defer pub.Close()
+	defer sub.Close()
+
+	messages, err := sub.Subscribe(context.Background(), topic)
+	require.NoError(t, err)
+
+	const messageCount = 20
+	for i := 0; i < messageCount; i++ {
+		err := pub.Publish(topic, message.NewMessage(watermill.NewUUID(), []byte(strconv.Itoa(i))))
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < messageCount; i++ {
+		select {
+		case received := <-messages:
+			require.Equal(t, strconv.Itoa(i), string(received.Payload))
+			received.Ack()
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for message %d", i)
+		}
+	}
+}
+
+// TestIdempotencyStoreDropsRedelivery exercises the ExactlyOnceDelivery factory's dedup path
+// directly: a second delivery under an already processed UUID (here, the same message
+// published twice) must be acked and dropped rather than forwarded to the output channel a
+// second time.
+func TestIdempotencyStoreDropsRedelivery(t *testing.T) {
+	pub, sub := createPubSubWithExactlyOnce(t)
+	defer pub.Close()
+	defer sub.Close()
+
+	topic := "idempotent_" + watermill.NewUUID()
+
+	messages, err := sub.Subscribe(context.Background(), topic)
+	require.NoError(t, err)
+
+	duplicated := message.NewMessage(watermill.NewUUID(), []byte("payload"))
+	require.NoError(t, pub.Publish(topic, duplicated))
+	require.NoError(t, pub.Publish(topic, duplicated))
+
+	received := 0
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-messages:
+			received++
+			msg.Ack()
+		case <-time.After(2 * time.Second):
+		}
+	}
+
+	require.Equal(t, 1, received)
+}
+
+// TestIdempotencyStoreAllowsRedeliveryAfterNack exercises the path TestIdempotencyStoreDropsRedelivery
+// doesn't: a message that is Nacked (so never reaches MarkSeen) must still be delivered and
+// processable on its next redelivery, rather than being mistaken for a duplicate.
+func TestIdempotencyStoreAllowsRedeliveryAfterNack(t *testing.T) {
+	pub, sub := createPubSubWithExactlyOnce(t)
+	defer pub.Close()
+	defer sub.Close()
+
+	topic := "idempotent_nack_" + watermill.NewUUID()
+
+	messages, err := sub.Subscribe(context.Background(), topic)
+	require.NoError(t, err)
+
+	published := message.NewMessage(watermill.NewUUID(), []byte("payload"))
+	require.NoError(t, pub.Publish(topic, published))
+
+	select {
+	case msg := <-messages:
+		msg.Nack()
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for first delivery")
+	}
+
+	select {
+	case msg := <-messages:
+		msg.Ack()
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for redelivery after nack")
+	}
+}
+
+// newBackoffPubSub builds a publisher and a subscriber configured with an unbounded
+// ExponentialBackoffDelay, for exercising the NakDelay/ConsumerBackOff wiring on the pull
+// consumer path.
+func newBackoffPubSub(t *testing.T, clientID string) (message.Publisher, message.Subscriber) {
+	natsURL := os.Getenv("WATERMILL_TEST_NATS_URL")
+	if natsURL == "" {
+		natsURL = nats.DefaultURL
+	}
+
+	format := os.Getenv("WATERMILL_TEST_NATS_FORMAT")
+	marshaler := msg.GetMarshaler(format)
+	logger := watermill.NopLogger{}
+
+	options := []nats.Option{
+		nats.RetryOnFailedConnect(true),
+		nats.Timeout(30 * time.Second),
+		nats.ReconnectWait(1 * time.Second),
+		nats.Name(clientID),
+	}
+
+	pub, err := wmnats.NewPublisher(wmnats.PublisherConfig{
+		URL:         natsURL,
+		Marshaler:   marshaler,
+		NatsOptions: options,
+		JetStream:   wmnats.JetStreamConfig{Enabled: true},
+	}, logger)
+	require.NoError(t, err)
+
+	sub, err := wmnats.NewSubscriber(wmnats.SubscriberConfig{
+		URL:            natsURL,
+		Unmarshaler:    marshaler,
+		NatsOptions:    options,
+		AckWaitTimeout: 30 * time.Second,
+		CloseTimeout:   30 * time.Second,
+		NakDelay:       &wmnats.ExponentialBackoffDelay{Base: 10 * time.Millisecond},
+		JetStream: wmnats.JetStreamConfig{
+			Enabled:       true,
+			AutoProvision: true,
+			PullConsumer: &wmnats.PullConsumerConfig{
+				Durable: "backoff",
+			},
+		},
+	}, logger)
+	require.NoError(t, err)
+
+	return pub, sub
+}
+
+// TestPullConsumerRedeliversWithUnboundedBackoff exercises SubscriberConfig.JetStream.PullConsumer
+// together with an ExponentialBackoffDelay that has TerminalDeliveryCount left at its "never
+// terminate" zero value: a Nacked message must keep being redelivered instead of being Termed
+// after the first retry, which is what would happen if MaxDeliver were wrongly capped to 1.
+func TestPullConsumerRedeliversWithUnboundedBackoff(t *testing.T) {
+	topic := "pull_backoff_" + watermill.NewUUID()
+	pub, sub := newBackoffPubSub(t, watermill.NewUUID())
+	defer pub.Close()
+	defer sub.Close()
+
+	messages, err := sub.Subscribe(context.Background(), topic)
+	require.NoError(t, err)
+
+	require.NoError(t, pub.Publish(topic, message.NewMessage(watermill.NewUUID(), []byte("payload"))))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case received := <-messages:
+			if i == 0 {
+				received.Nack()
+				continue
+			}
+			received.Ack()
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for delivery %d", i)
+		}
+	}
+}
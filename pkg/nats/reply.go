@@ -0,0 +1,33 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// replyToHeader carries the application-level reply inbox for Publisher.Request, distinct from
+// nats.Msg.Reply. nats.Msg.Reply doubles as the ack-reply subject the client publishes
+// "+ACK"/"-NAK"/"+TERM" to whenever it's non-empty, independent of JetStream, so routing
+// application replies through it would race (and collide with) the Subscriber's own Ack/Nak
+// handling on every request/reply message.
+const replyToHeader = "Watermill-Reply-To"
+
+type replyContextKey struct{}
+
+func withReply(ctx context.Context, reply func(*message.Message) error) context.Context {
+	return context.WithValue(ctx, replyContextKey{}, reply)
+}
+
+// Reply sends reply to the inbox carried in original's replyToHeader, if any.
+// It returns false, and sends nothing, if original was not received with that header
+// (for example, it wasn't sent with Publisher.Request) or if the Subscriber's Unmarshaler
+// does not also implement Marshaler.
+func Reply(original *message.Message, reply *message.Message) (bool, error) {
+	fn, ok := original.Context().Value(replyContextKey{}).(func(*message.Message) error)
+	if !ok {
+		return false, nil
+	}
+
+	return true, fn(reply)
+}
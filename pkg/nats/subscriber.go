@@ -63,10 +63,30 @@ type SubscriberConfig struct {
 	// By default, it's NACKed without delay.
 	NakDelay Delay
 
+	// DurableCalculator is a function used to compute the JetStream durable consumer name for
+	// a given topic and queue group (defaults to returning queueGroup unchanged).
+	//
+	// Without it, multiple topics subscribed to under the same QueueGroup collide on a single
+	// shared JetStream consumer and steal each other's messages. Set it to derive a distinct
+	// durable name per topic, e.g. func(topic, queueGroup string) string { return queueGroup + "-" + topic }.
+	DurableCalculator DurableCalculator
+
+	// DeadLetterPublisher, when set, republishes a message to DeadLetterTopic immediately
+	// before it is Termed (i.e. NakDelay.WaitTime returned StopTime), so it isn't silently
+	// dropped once redelivery is given up on.
+	DeadLetterPublisher message.Publisher
+
+	// DeadLetterTopic computes the dead-letter topic for a given source topic. Defaults to
+	// appending ".dead-letter" to the source topic. Only used when DeadLetterPublisher is set.
+	DeadLetterTopic func(topic string) string
+
 	// JetStream holds JetStream specific settings
 	JetStream JetStreamConfig
 }
 
+// DurableCalculator computes the JetStream durable consumer name for a topic/queueGroup pair.
+type DurableCalculator func(topic, queueGroup string) string
+
 // SubscriberSubscriptionConfig is the configurationz
 type SubscriberSubscriptionConfig struct {
 	// Unmarshaler is an unmarshaler used to unmarshaling messages from NATS format to Watermill format.
@@ -110,6 +130,19 @@ type SubscriberSubscriptionConfig struct {
 	// By default, it's NACKed without delay.
 	NakDelay Delay
 
+	// DurableCalculator is a function used to compute the JetStream durable consumer name for
+	// a given topic and queue group (defaults to returning queueGroup unchanged).
+	DurableCalculator DurableCalculator
+
+	// DeadLetterPublisher, when set, republishes a message to DeadLetterTopic immediately
+	// before it is Termed (i.e. NakDelay.WaitTime returned StopTime), so it isn't silently
+	// dropped once redelivery is given up on.
+	DeadLetterPublisher message.Publisher
+
+	// DeadLetterTopic computes the dead-letter topic for a given source topic. Defaults to
+	// appending ".dead-letter" to the source topic. Only used when DeadLetterPublisher is set.
+	DeadLetterTopic func(topic string) string
+
 	// JetStream holds JetStream specific settings
 	JetStream JetStreamConfig
 }
@@ -117,16 +150,19 @@ type SubscriberSubscriptionConfig struct {
 // GetSubscriberSubscriptionConfig gets the configuration subset needed for individual subscribe calls once a connection has been established
 func (c *SubscriberConfig) GetSubscriberSubscriptionConfig() SubscriberSubscriptionConfig {
 	return SubscriberSubscriptionConfig{
-		Unmarshaler:       c.Unmarshaler,
-		QueueGroup:        c.QueueGroup,
-		SubscribersCount:  c.SubscribersCount,
-		AckWaitTimeout:    c.AckWaitTimeout,
-		CloseTimeout:      c.CloseTimeout,
-		SubscribeTimeout:  c.SubscribeTimeout,
-		SubjectCalculator: c.SubjectCalculator,
-		AckSync:           c.AckSync,
-		NakDelay:          c.NakDelay,
-		JetStream:         c.JetStream,
+		Unmarshaler:         c.Unmarshaler,
+		QueueGroup:          c.QueueGroup,
+		SubscribersCount:    c.SubscribersCount,
+		AckWaitTimeout:      c.AckWaitTimeout,
+		CloseTimeout:        c.CloseTimeout,
+		SubscribeTimeout:    c.SubscribeTimeout,
+		SubjectCalculator:   c.SubjectCalculator,
+		AckSync:             c.AckSync,
+		NakDelay:            c.NakDelay,
+		DurableCalculator:   c.DurableCalculator,
+		DeadLetterPublisher: c.DeadLetterPublisher,
+		DeadLetterTopic:     c.DeadLetterTopic,
+		JetStream:           c.JetStream,
 	}
 }
 
@@ -151,6 +187,18 @@ func (c *SubscriberSubscriptionConfig) setDefaults() {
 	if c.SubjectCalculator == nil {
 		c.SubjectCalculator = DefaultSubjectCalculator
 	}
+
+	if c.DurableCalculator == nil {
+		c.DurableCalculator = func(topic, queueGroup string) string {
+			return queueGroup
+		}
+	}
+
+	if c.DeadLetterPublisher != nil && c.DeadLetterTopic == nil {
+		c.DeadLetterTopic = func(topic string) string {
+			return topic + ".dead-letter"
+		}
+	}
 }
 
 // Validate ensures configuration is valid before use
@@ -159,7 +207,7 @@ func (c *SubscriberSubscriptionConfig) Validate() error {
 		return errors.New("SubscriberConfig.Unmarshaler is missing")
 	}
 
-	if c.QueueGroup == "" && c.SubscribersCount > 1 {
+	if c.QueueGroup == "" && c.SubscribersCount > 1 && c.JetStream.PullConsumer == nil {
 		return errors.New(
 			"to set SubscriberConfig.SubscribersCount " +
 				"you need to also set SubscriberConfig.QueueGroup, " +
@@ -167,6 +215,26 @@ func (c *SubscriberSubscriptionConfig) Validate() error {
 		)
 	}
 
+	if c.JetStream.PullConsumer != nil && c.QueueGroup != "" {
+		return errors.New(
+			"SubscriberConfig.JetStream.PullConsumer cannot be combined with SubscriberConfig.QueueGroup, " +
+				"pull consumers scale horizontally without a queue group",
+		)
+	}
+
+	if c.JetStream.Ordered && (c.QueueGroup != "" || c.SubscribersCount > 1) {
+		return errors.New(
+			"SubscriberConfig.JetStream.Ordered guarantees order for a single subscriber " +
+				"and cannot be combined with SubscriberConfig.QueueGroup or SubscribersCount > 1",
+		)
+	}
+
+	if c.JetStream.Ordered && c.JetStream.PullConsumer != nil {
+		return errors.New(
+			"SubscriberConfig.JetStream.Ordered cannot be combined with SubscriberConfig.JetStream.PullConsumer",
+		)
+	}
+
 	if c.SubjectCalculator == nil {
 		return errors.New("SubscriberSubscriptionConfig.SubjectCalculator is required.")
 	}
@@ -188,6 +256,7 @@ type Subscriber struct {
 
 	outputsWg        sync.WaitGroup
 	topicInterpreter *topicInterpreter
+	idempotencyStore *idempotencyStore
 }
 
 // NewSubscriber creates a new Subscriber.
@@ -213,8 +282,19 @@ func NewSubscriberWithNatsConn(conn *nats.Conn, config SubscriberSubscriptionCon
 
 	var connection Connection = conn
 	var interpreter *topicInterpreter
+	var idempotency *idempotencyStore
 
 	if config.JetStream.Enabled {
+		if backoff, ok := config.NakDelay.(*ExponentialBackoffDelay); ok && config.JetStream.ConsumerBackOff == nil {
+			config.JetStream.ConsumerBackOff = backoff.Schedule()
+			// TerminalDeliveryCount == 0 means "never terminate" (see delay.go), so leave
+			// MaxDeliver unset in that case - nats.MaxDeliver(1) would tell the server to never
+			// redeliver at all, silently disabling retries for the common unbounded-backoff setup.
+			if config.JetStream.MaxDeliver == 0 && backoff.TerminalDeliveryCount > 0 {
+				config.JetStream.MaxDeliver = int(backoff.TerminalDeliveryCount) + 1
+			}
+		}
+
 		js, err := conn.JetStream(config.JetStream.ConnectOptions...)
 
 		connection = &jsConnection{conn, js, config.JetStream}
@@ -224,6 +304,13 @@ func NewSubscriberWithNatsConn(conn *nats.Conn, config SubscriberSubscriptionCon
 		}
 
 		interpreter = newTopicInterpreter(js, config.SubjectCalculator)
+
+		if config.JetStream.IdempotencyStore != nil {
+			idempotency, err = newIdempotencyStore(js, *config.JetStream.IdempotencyStore)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return &Subscriber{
@@ -232,6 +319,7 @@ func NewSubscriberWithNatsConn(conn *nats.Conn, config SubscriberSubscriptionCon
 		config:           config,
 		closing:          make(chan struct{}),
 		topicInterpreter: interpreter,
+		idempotencyStore: idempotency,
 	}, nil
 }
 
@@ -252,9 +340,7 @@ func (s *Subscriber) Subscribe(ctx context.Context, topic string) (<-chan *messa
 
 		s.logger.Debug("Starting subscriber", subscriberLogFields)
 
-		sub, err := s.subscribe(topic, func(msg *nats.Msg) {
-			s.processMessage(ctx, msg, output, subscriberLogFields)
-		})
+		sub, err := s.subscribe(ctx, topic, output, subscriberLogFields)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot subscribe")
 		}
@@ -294,7 +380,12 @@ func (s *Subscriber) SubscribeInitialize(topic string) error {
 	return nil
 }
 
-func (s *Subscriber) subscribe(topic string, cb nats.MsgHandler) (*nats.Subscription, error) {
+func (s *Subscriber) subscribe(
+	ctx context.Context,
+	topic string,
+	output chan *message.Message,
+	logFields watermill.LogFields,
+) (*nats.Subscription, error) {
 	if s.config.JetStream.Enabled && s.config.JetStream.AutoProvision {
 		err := s.SubscribeInitialize(topic)
 		if err != nil {
@@ -304,16 +395,152 @@ func (s *Subscriber) subscribe(topic string, cb nats.MsgHandler) (*nats.Subscrip
 
 	primarySubject := s.config.SubjectCalculator(topic).Primary
 
-	return s.conn.QueueSubscribe(
-		primarySubject,
-		s.config.QueueGroup,
-		cb,
-	)
+	if pc := s.config.JetStream.PullConsumer; pc != nil {
+		return s.subscribePull(primarySubject, pc, ctx, topic, output, logFields)
+	}
+
+	if s.config.JetStream.Ordered {
+		return s.subscribeOrdered(primarySubject, ctx, topic, output, logFields)
+	}
+
+	cb := func(msg *nats.Msg) {
+		s.processMessage(ctx, msg, topic, output, logFields)
+	}
+
+	if s.config.JetStream.Enabled {
+		if jsSub, ok := s.conn.(jsSubscriber); ok {
+			if s.config.QueueGroup == "" {
+				return jsSub.Subscribe(primarySubject, cb, s.jetStreamRedeliveryOpts()...)
+			}
+			durable := s.config.DurableCalculator(topic, s.config.QueueGroup)
+			return jsSub.QueueSubscribeDurable(primarySubject, s.config.QueueGroup, durable, cb, s.jetStreamRedeliveryOpts()...)
+		}
+	}
+
+	return s.conn.QueueSubscribe(primarySubject, s.config.QueueGroup, cb)
+}
+
+// jetStreamRedeliveryOpts translates JetStreamConfig.MaxDeliver/ConsumerBackOff into the
+// matching nats.go SubOpts, so the consumer created server-side enforces the same redelivery
+// schedule as SubscriberConfig.NakDelay even for messages the client crashes before seeing.
+func (s *Subscriber) jetStreamRedeliveryOpts() []nats.SubOpt {
+	var opts []nats.SubOpt
+
+	if s.config.JetStream.MaxDeliver > 0 {
+		opts = append(opts, nats.MaxDeliver(s.config.JetStream.MaxDeliver))
+	}
+	if len(s.config.JetStream.ConsumerBackOff) > 0 {
+		opts = append(opts, nats.BackOff(s.config.JetStream.ConsumerBackOff))
+	}
+
+	return opts
+}
+
+// subscribePull creates a JetStream pull consumer and starts a goroutine that repeatedly
+// calls Fetch, feeding every delivered message into processMessage. It provides the same
+// horizontal scaling as a queue group, but with explicit back-pressure via BatchSize.
+func (s *Subscriber) subscribePull(
+	subject string,
+	pc *PullConsumerConfig,
+	ctx context.Context,
+	topic string,
+	output chan *message.Message,
+	logFields watermill.LogFields,
+) (*nats.Subscription, error) {
+	pc.setDefaults()
+
+	jsSub, ok := s.conn.(jsSubscriber)
+	if !ok {
+		return nil, errors.New("JetStream.PullConsumer requires JetStream to be enabled")
+	}
+
+	durable := s.config.DurableCalculator(topic, pc.Durable)
+
+	opts := append([]nats.SubOpt{nats.ManualAck()}, s.jetStreamRedeliveryOpts()...)
+	if pc.MaxAckPending > 0 {
+		opts = append(opts, nats.MaxAckPending(pc.MaxAckPending))
+	}
+
+	sub, err := jsSub.PullSubscribe(subject, durable, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create pull consumer")
+	}
+
+	go func() {
+		for {
+			select {
+			case <-s.closing:
+				return
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			msgs, err := sub.Fetch(pc.BatchSize, nats.MaxWait(pc.MaxWait))
+			if err != nil {
+				if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+					continue
+				}
+				if s.isClosed() {
+					return
+				}
+				s.logger.Error("Cannot fetch from pull consumer", err, logFields)
+				continue
+			}
+
+			for _, msg := range msgs {
+				s.processMessage(ctx, msg, topic, output, logFields)
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// subscribeOrdered creates an ephemeral JetStream ordered consumer (nats.OrderedConsumer()).
+// nats.go already detects gaps in the delivered consumer sequence and flow-control/heartbeat
+// failures and transparently resets the consumer in place to recover from them, so this only
+// needs to create the subscription and surface whatever it reports through the logger -
+// tearing down and recreating the subscription from application code would race the client's
+// own internal recovery.
+func (s *Subscriber) subscribeOrdered(
+	subject string,
+	ctx context.Context,
+	topic string,
+	output chan *message.Message,
+	logFields watermill.LogFields,
+) (*nats.Subscription, error) {
+	jsSub, ok := s.conn.(jsSubscriber)
+	if !ok {
+		return nil, errors.New("JetStream.Ordered requires JetStream to be enabled")
+	}
+
+	opts := append([]nats.SubOpt{nats.OrderedConsumer()}, s.jetStreamRedeliveryOpts()...)
+
+	if s.config.JetStream.IdleHeartbeat > 0 {
+		opts = append(opts, nats.IdleHeartbeat(s.config.JetStream.IdleHeartbeat))
+	}
+	if s.config.JetStream.FlowControl {
+		opts = append(opts, nats.EnableFlowControl())
+	}
+	opts = append(opts, nats.ConsumerErrHandler(func(_ *nats.Subscription, err error) {
+		s.logger.Error("Ordered consumer reported an error, nats.go is recovering it internally", err, logFields)
+	}))
+
+	sub, err := jsSub.Subscribe(subject, func(msg *nats.Msg) {
+		s.processMessage(ctx, msg, topic, output, logFields)
+	}, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create ordered consumer")
+	}
+
+	return sub, nil
 }
 
 func (s *Subscriber) processMessage(
 	ctx context.Context,
 	m *nats.Msg,
+	topic string,
 	output chan *message.Message,
 	logFields watermill.LogFields,
 ) {
@@ -328,7 +555,41 @@ func (s *Subscriber) processMessage(
 	messageLogFields := logFields.Add(watermill.LogFields{"message_uuid": msg.UUID})
 	s.logger.Trace("Unmarshaled message", messageLogFields)
 
+	if s.idempotencyStore != nil {
+		seen, err := s.idempotencyStore.Seen(msg.UUID)
+		if err != nil {
+			s.logger.Error("Cannot check idempotency store, processing message anyway", err, messageLogFields)
+		} else if seen {
+			s.logger.Debug("Message already processed, acking and dropping", messageLogFields)
+			if err := m.Ack(); err != nil {
+				s.logger.Error("Cannot ack duplicate message", err, messageLogFields)
+			}
+			return
+		}
+	}
+
 	ctx, cancelCtx := context.WithCancel(ctx)
+
+	// See replyToHeader for why the reply inbox travels in a header rather than m.Reply.
+	if replyInbox := m.Header.Get(replyToHeader); replyInbox != "" {
+		if marshaler, ok := s.config.Unmarshaler.(Marshaler); ok {
+			ctx = withReply(ctx, func(reply *message.Message) error {
+				replyMsg, err := marshaler.Marshal(replyInbox, reply)
+				if err != nil {
+					return errors.Wrap(err, "cannot marshal reply")
+				}
+				replyMsg.Reply = ""
+				return s.conn.PublishMsg(replyMsg)
+			})
+		} else {
+			s.logger.Error(
+				"Message has a reply-to header but SubscriberConfig.Unmarshaler does not implement Marshaler, cannot reply",
+				nil,
+				messageLogFields,
+			)
+		}
+	}
+
 	msg.SetContext(ctx)
 	defer cancelCtx()
 
@@ -370,6 +631,12 @@ func (s *Subscriber) processMessage(
 			return
 		}
 		s.logger.Trace("Message Acked", messageLogFields)
+
+		if s.idempotencyStore != nil {
+			if err := s.idempotencyStore.MarkSeen(msg.UUID); err != nil {
+				s.logger.Error("Cannot mark message as seen in idempotency store", err, messageLogFields)
+			}
+		}
 	case <-msg.Nacked():
 		if m.Reply == "" {
 			s.logger.Trace("Ignoring nack without reply topic", messageLogFields)
@@ -392,6 +659,17 @@ func (s *Subscriber) processMessage(
 		}
 
 		if nakDelay == StopTime {
+			if s.config.DeadLetterPublisher != nil {
+				deadLetterTopic := s.config.DeadLetterTopic(topic)
+				if err := s.config.DeadLetterPublisher.Publish(deadLetterTopic, msg); err != nil {
+					s.logger.Error("Cannot publish to dead letter topic", err, messageLogFields)
+				} else {
+					s.logger.Trace("Message republished to dead letter topic", messageLogFields.Add(watermill.LogFields{
+						"dead_letter_topic": deadLetterTopic,
+					}))
+				}
+			}
+
 			if err := m.Term(); err != nil {
 				s.logger.Error("Cannot send term", err, messageLogFields)
 			} else {